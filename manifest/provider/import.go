@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/davecgh/go-spew/spew"
@@ -10,27 +14,55 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
 )
 
+// importFieldManagerAll is the `import_field_manager` value that disables field-manager pruning and
+// preserves the pre-pruning behavior of importing the full resource body.
+const importFieldManagerAll = "*"
+
+// importFieldManagerDefault is the field manager import_field_manager resolves to when the provider
+// option is left unset, matching the manager name the provider itself applies under.
+const importFieldManagerDefault = "terraform"
+
+// importFieldManager returns the configured `import_field_manager` provider-level option (declared
+// alongside the provider's other top-level options in schema.go), defaulting to
+// importFieldManagerDefault when it hasn't been set. The special value importFieldManagerAll ("*")
+// disables field-manager pruning on import.
+func (s *RawProviderServer) importFieldManager() (string, error) {
+	v, ok := s.providerConfig["import_field_manager"]
+	if !ok || v.IsNull() {
+		return importFieldManagerDefault, nil
+	}
+	var fm string
+	if err := v.As(&fm); err != nil {
+		return "", fmt.Errorf("failed to decode import_field_manager provider option: %w", err)
+	}
+	if fm == "" {
+		return importFieldManagerDefault, nil
+	}
+	return fm, nil
+}
+
 // ImportResourceState function
 func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
 	// Terraform only gives us the schema name of the resource and an ID string, as passed by the user on the command line.
 	// The ID should be a combination of a Kubernetes GVK and a namespace/name type of resource identifier.
 	// Without the user supplying the GRV there is no way to fully identify the resource when making the Get API call to K8s.
 	// Presumably the Kubernetes API machinery already has a standard for expressing such a group. We should look there first.
+	//
+	// Besides importing a single object, the ID can also carry one of two bulk-import prefixes:
+	// "selector:" to import every object matching a label selector, and "namespace:" to import every
+	// instance of a Kind in a namespace (or cluster-wide). It can also be "file://path/to/manifest.yaml"
+	// (or "-" for stdin) to import the object(s) described by a checked-in manifest instead of a
+	// hand-crafted ID. All of those can produce more than one ImportedResource in the response.
 	resp := &tfprotov5.ImportResourceStateResponse{}
-	gvk, name, namespace, err := parseImportID(req.ID)
-	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
-			Severity: tfprotov5.DiagnosticSeverityError,
-			Summary:  "Failed to parse import ID",
-			Detail:   err.Error(),
-		})
-	}
-	s.logger.Trace("[ImportResourceState]", "[ID]", gvk, name, namespace)
 	rt, err := GetResourceType(req.TypeName)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -58,6 +90,35 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		})
 		return resp, nil
 	}
+	fieldManager, err := s.importFieldManager()
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine import_field_manager",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	switch {
+	case strings.HasPrefix(req.ID, "selector:"):
+		return s.importResourcesBySelector(ctx, req.TypeName, rt, rm, client, fieldManager, strings.TrimPrefix(req.ID, "selector:"))
+	case strings.HasPrefix(req.ID, "namespace:"):
+		return s.importResourcesByNamespace(ctx, req.TypeName, rt, rm, client, fieldManager, strings.TrimPrefix(req.ID, "namespace:"))
+	case strings.HasPrefix(req.ID, "file://"), req.ID == "-":
+		return s.importResourcesFromManifestFile(ctx, req.TypeName, rt, rm, client, fieldManager, req.ID)
+	}
+
+	gvk, name, namespace, err := parseImportID(req.ID, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to parse import ID",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	s.logger.Trace("[ImportResourceState]", "[ID]", gvk, name, namespace)
 	ns, err := IsResourceNamespaced(gvk, rm)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -99,80 +160,569 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		})
 		return resp, nil
 	}
+
+	ir, diags := s.importedResourceFromObject(ctx, req.TypeName, rt, gvk, ro, fieldManager)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if ir != nil {
+		resp.ImportedResources = append(resp.ImportedResources, ir)
+	}
+	return resp, nil
+}
+
+// importResourcesBySelector implements the "selector:<Kind>[.<apiGroup>.<apiVersion>]/<namespace>/<labelSelector>"
+// bulk import ID: it lists every object of the given Kind in the namespace matching labelSelector and
+// returns one ImportedResource per match.
+func (s *RawProviderServer) importResourcesBySelector(ctx context.Context, typeName string, rt tftypes.Type, rm meta.RESTMapper, client dynamic.Interface, fieldManager string, spec string) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp := &tfprotov5.ImportResourceStateResponse{}
+	gvk, namespace, selector, err := parseSelectorImportID(spec, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to parse selector import ID",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	s.logger.Trace("[ImportResourceState]", "[Selector]", gvk, namespace, selector)
+
+	io := unstructured.Unstructured{}
+	io.SetKind(gvk.Kind)
+	io.SetAPIVersion(gvk.GroupVersion().String())
+	gvr, err := GVRFromUnstructured(&io, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to get GVR from GVK via RESTMapper",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	objs, err := client.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Failed to list resources matching %s", spew.Sdump(io)),
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	for i := range objs.Items {
+		ir, diags := s.importedResourceFromObject(ctx, typeName, rt, gvk, &objs.Items[i], fieldManager)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if ir != nil {
+			resp.ImportedResources = append(resp.ImportedResources, ir)
+		}
+	}
+	return resp, nil
+}
+
+// importResourcesByNamespace implements the "namespace:<Kind>[.<apiGroup>.<apiVersion>]/<namespace-or-*>"
+// bulk import ID: it lists every object of the given Kind in the namespace (or cluster-wide when the
+// namespace is "*" or empty, as in "Pod/") and returns one ImportedResource per match. A spec with no
+// '/' at all is rejected by parseNamespaceImportID.
+func (s *RawProviderServer) importResourcesByNamespace(ctx context.Context, typeName string, rt tftypes.Type, rm meta.RESTMapper, client dynamic.Interface, fieldManager string, spec string) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp := &tfprotov5.ImportResourceStateResponse{}
+	gvk, namespace, err := parseNamespaceImportID(spec, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to parse namespace import ID",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	s.logger.Trace("[ImportResourceState]", "[Namespace]", gvk, namespace)
+
+	io := unstructured.Unstructured{}
+	io.SetKind(gvk.Kind)
+	io.SetAPIVersion(gvk.GroupVersion().String())
+	gvr, err := GVRFromUnstructured(&io, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to get GVR from GVK via RESTMapper",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	rcl := client.Resource(gvr)
+	var objs *unstructured.UnstructuredList
+	if namespace == "" || namespace == "*" {
+		objs, err = rcl.List(ctx, metav1.ListOptions{})
+	} else {
+		objs, err = rcl.Namespace(namespace).List(ctx, metav1.ListOptions{})
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Failed to list resources matching %s", spew.Sdump(io)),
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	for i := range objs.Items {
+		ir, diags := s.importedResourceFromObject(ctx, typeName, rt, gvk, &objs.Items[i], fieldManager)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if ir != nil {
+			resp.ImportedResources = append(resp.ImportedResources, ir)
+		}
+	}
+	return resp, nil
+}
+
+// importResourcesFromManifestFile implements the "file://path/to/manifest.yaml[#documentIndex]" (or "-"
+// for stdin) import ID: it reads the manifest, derives GVK/namespace/name from the indicated document
+// (or from every document, if no index was given), fetches the corresponding live object for each and
+// returns one ImportedResource per document. Relative paths are resolved the same way any other file
+// path passed to the plugin would be, i.e. relative to the plugin's own working directory.
+func (s *RawProviderServer) importResourcesFromManifestFile(ctx context.Context, typeName string, rt tftypes.Type, rm meta.RESTMapper, client dynamic.Interface, fieldManager string, id string) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp := &tfprotov5.ImportResourceStateResponse{}
+	path, docIndex, hasDocIndex, err := parseManifestFileImportID(id)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to parse manifest file import ID",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, ferr := os.Open(path)
+		if ferr != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to open manifest file %q", path),
+				Detail:   ferr.Error(),
+			})
+			return resp, nil
+		}
+		defer f.Close()
+		r = f
+	}
+
+	docs, err := decodeYAMLDocuments(r)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Failed to parse manifest file %q", path),
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	if hasDocIndex {
+		if docIndex < 0 || docIndex >= len(docs) {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Document index out of range",
+				Detail:   fmt.Sprintf("manifest file %q has %d document(s), requested index %d", path, len(docs), docIndex),
+			})
+			return resp, nil
+		}
+		docs = docs[docIndex : docIndex+1]
+	}
+
+	for _, doc := range docs {
+		do := &unstructured.Unstructured{Object: doc}
+		gvk := do.GroupVersionKind()
+		name := do.GetName()
+		namespace := do.GetNamespace()
+
+		ns, err := IsResourceNamespaced(gvk, rm)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to get namespacing requirement from RESTMapper",
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		gvr, err := GVRFromUnstructured(do, rm)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to get GVR from GVK via RESTMapper",
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		rcl := client.Resource(gvr)
+
+		var ro *unstructured.Unstructured
+		if ns {
+			ro, err = rcl.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		} else {
+			ro, err = rcl.Get(ctx, name, metav1.GetOptions{})
+		}
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to get resource %s from API", spew.Sdump(do)),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+
+		ir, diags := s.importedResourceFromObject(ctx, typeName, rt, gvk, ro, fieldManager)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if ir != nil {
+			resp.ImportedResources = append(resp.ImportedResources, ir)
+		}
+	}
+	return resp, nil
+}
+
+// parseManifestFileImportID splits a "file://path/to/manifest.yaml[#documentIndex]" (or "-") import ID
+// into the file path to read and, if present, the zero-based index of the document to import.
+func parseManifestFileImportID(id string) (path string, docIndex int, hasDocIndex bool, err error) {
+	if id == "-" {
+		path = "-"
+		return
+	}
+	rest := strings.TrimPrefix(id, "file://")
+	idx := strings.LastIndex(rest, "#")
+	if idx < 0 {
+		path = rest
+		return
+	}
+	path = rest[:idx]
+	docIndex, err = strconv.Atoi(rest[idx+1:])
+	if err != nil {
+		err = fmt.Errorf("invalid document index in import ID [%s]: %w", id, err)
+		return
+	}
+	hasDocIndex = true
+	return
+}
+
+// decodeYAMLDocuments reads every YAML or JSON document from r, skipping empty ones (e.g. a trailing
+// "---").
+func decodeYAMLDocuments(r io.Reader) ([]map[string]interface{}, error) {
+	dec := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	var docs []map[string]interface{}
+	for {
+		var doc map[string]interface{}
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(doc) == 0 {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// importedResourceFromObject converts a live Kubernetes object into the tfprotov5.ImportedResource
+// that ImportResourceState returns, populating "object", "manifest" and "wait_for" the same way
+// regardless of whether it was reached through a single-object or a bulk import ID.
+//
+// Before converting, the object is pruned down to the fields owned by fieldManager according to its
+// metadata.managedFields (see pruneToFieldManager), unless fieldManager is importFieldManagerAll, so
+// that the imported state does not claim ownership, under server-side apply, of fields other
+// controllers manage. Any pruned paths are surfaced as a warning diagnostic.
+func (s *RawProviderServer) importedResourceFromObject(ctx context.Context, typeName string, rt tftypes.Type, gvk schema.GroupVersionKind, ro *unstructured.Unstructured, fieldManager string) (*tfprotov5.ImportedResource, []*tfprotov5.Diagnostic) {
 	s.logger.Trace("[ImportResourceState]", "[API Resource]", spew.Sdump(ro))
 
 	objectType, err := s.TFTypeFromOpenAPI(ctx, gvk, false)
 	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		return nil, []*tfprotov5.Diagnostic{{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  fmt.Sprintf("Failed to determine resource type from GVK: %s", gvk),
 			Detail:   err.Error(),
+		}}
+	}
+
+	content, prunedPaths, err := pruneToFieldManager(ro, fieldManager)
+	if err != nil {
+		return nil, []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Failed to prune resource to field manager %q", fieldManager),
+			Detail:   err.Error(),
+		}}
+	}
+	var diags []*tfprotov5.Diagnostic
+	if len(prunedPaths) > 0 {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  fmt.Sprintf("Fields owned by other field managers were not imported (import_field_manager = %q)", fieldManager),
+			Detail:   "The following fields are managed by a field manager other than the configured one and were left out of the imported state:\n" + strings.Join(prunedPaths, "\n"),
 		})
-		return resp, nil
 	}
 
-	fo := RemoveServerSideFields(ro.UnstructuredContent())
+	fo := RemoveServerSideFields(content)
 	nobj, err := payload.ToTFValue(fo, objectType, tftypes.NewAttributePath())
 	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		return nil, append(diags, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  "Failed to convert unstructured to tftypes.Value",
 			Detail:   err.Error(),
 		})
-		return resp, nil
 	}
 	nobj, err = morph.DeepUnknown(objectType, nobj, tftypes.NewAttributePath())
 	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		return nil, append(diags, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  "Failed to backfill unknown values during import",
 			Detail:   err.Error(),
 		})
-		return resp, nil
 	}
 	s.logger.Trace("[ImportResourceState]", "[tftypes.Value]", spew.Sdump(nobj))
 
+	// Populate "manifest" from the live object too, rather than leaving it empty, so that
+	// `terraform plan -generate-config-out` has something concrete to render as HCL. We strip
+	// "status" in addition to the server-side fields already removed above, since "status" is
+	// never something a user would want to manage from their configuration.
+	mc := make(map[string]interface{}, len(fo))
+	for k, v := range fo {
+		if k == "status" {
+			continue
+		}
+		mc[k] = v
+	}
+	// Like objectType above, the "manifest" attribute type on rt is only a generic/dynamic placeholder
+	// at this point (rt is resolved from req.TypeName before any GVK is known), so it must be derived
+	// from the GVK the same way objectType is, rather than read off rt.
+	manifestType, err := s.TFTypeFromOpenAPI(ctx, gvk, false)
+	if err != nil {
+		return nil, append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Failed to determine manifest type from GVK: %s", gvk),
+			Detail:   err.Error(),
+		})
+	}
+	mval, err := payload.ToTFValue(mc, manifestType, tftypes.NewAttributePath())
+	if err != nil {
+		return nil, append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to convert unstructured to tftypes.Value for manifest",
+			Detail:   err.Error(),
+		})
+	}
+
 	newState := make(map[string]tftypes.Value)
 	wftype := rt.(tftypes.Object).AttributeTypes["wait_for"]
-	newState["manifest"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, nil)
+	newState["manifest"] = mval
 	newState["object"] = morph.UnknownToNull(nobj)
 	newState["wait_for"] = tftypes.NewValue(wftype, nil)
 	nsVal := tftypes.NewValue(rt, newState)
 
 	impState, err := tfprotov5.NewDynamicValue(nsVal.Type(), nsVal)
 	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		return nil, append(diags, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
 			Summary:  "Failed to construct dynamic value for imported state",
 			Detail:   err.Error(),
 		})
-		return resp, nil
 	}
-	resp.ImportedResources = append(resp.ImportedResources, &tfprotov5.ImportedResource{
-		TypeName: req.TypeName,
+	return &tfprotov5.ImportedResource{
+		TypeName: typeName,
 		State:    &impState,
-	})
-	return resp, nil
+	}, diags
+}
+
+// serverSideMetadataFields lists metadata.* fields that are plain system bookkeeping: they are never
+// recorded as owned by any manager in managedFields, regardless of who created or last touched the
+// object, so pruneUnowned always drops them. RemoveServerSideFields strips them from the final object
+// a few lines below where pruneToFieldManager is called anyway, so losing them here changes nothing -
+// but without this list they'd be reported as "owned by another field manager" on every single import.
+var serverSideMetadataFields = []string{
+	"resourceVersion",
+	"uid",
+	"creationTimestamp",
+	"generation",
+	"managedFields",
+}
+
+// pruneToFieldManager filters obj's content down to the fields owned by manager, according to
+// metadata.managedFields, so that importing a resource that other controllers partially manage does
+// not cause Terraform to claim ownership of their fields under server-side apply on the next apply.
+// The special value importFieldManagerAll ("*") disables pruning and returns the object unchanged,
+// matching the provider's pre-import_field_manager behavior. It returns the pruned content along with
+// the dotted paths of the fields that were removed (nested ones included, e.g.
+// "metadata.labels.app"), for use in a diagnostic.
+func pruneToFieldManager(ro *unstructured.Unstructured, manager string) (map[string]interface{}, []string, error) {
+	content := ro.UnstructuredContent()
+	if manager == importFieldManagerAll {
+		return content, nil, nil
+	}
+
+	owned := fieldpath.NewSet()
+	for _, mf := range ro.GetManagedFields() {
+		if mf.Manager != manager || mf.FieldsV1 == nil {
+			continue
+		}
+		var mfset fieldpath.Set
+		if err := mfset.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode managedFields for manager %q: %w", manager, err)
+		}
+		owned = owned.Union(&mfset)
+	}
+
+	var pruned []string
+	kept, _ := pruneUnowned(content, owned, nil, &pruned).(map[string]interface{})
+	if kept == nil {
+		kept = map[string]interface{}{}
+	}
+
+	// apiVersion/kind are structural and never tracked in managedFields, and metadata.name/namespace
+	// are required to locate the object on a subsequent Read: always keep them, regardless of what
+	// pruneUnowned decided for their containing maps, and don't count them as pruned.
+	for _, k := range []string{"apiVersion", "kind"} {
+		if v, ok := content[k]; ok {
+			kept[k] = v
+		}
+	}
+	if md, ok := content["metadata"].(map[string]interface{}); ok {
+		kmd, _ := kept["metadata"].(map[string]interface{})
+		if kmd == nil {
+			kmd = map[string]interface{}{}
+		}
+		for _, k := range []string{"name", "namespace"} {
+			if v, ok := md[k]; ok {
+				kmd[k] = v
+			}
+		}
+		kept["metadata"] = kmd
+	}
+	removePaths := append([]string{"apiVersion", "kind", "metadata", "metadata.name", "metadata.namespace"}, serverSideMetadataPaths()...)
+	pruned = removePrunedPaths(pruned, removePaths...)
+
+	return kept, pruned, nil
+}
+
+// serverSideMetadataPaths returns serverSideMetadataFields as dotted "metadata.<field>" paths, matching
+// the format pruneUnowned records in its pruned-paths list.
+func serverSideMetadataPaths() []string {
+	paths := make([]string, len(serverSideMetadataFields))
+	for i, k := range serverSideMetadataFields {
+		paths[i] = "metadata." + k
+	}
+	return paths
+}
+
+// removePrunedPaths returns pruned with any of the given paths removed, used to un-report paths that
+// pruneToFieldManager decided to keep after pruneUnowned already recorded them as dropped.
+func removePrunedPaths(pruned []string, remove ...string) []string {
+	out := pruned[:0]
+	for _, p := range pruned {
+		drop := false
+		for _, r := range remove {
+			if p == r {
+				drop = true
+				break
+			}
+		}
+		if !drop {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// pruneUnowned recursively keeps only the map fields present in owned (or one of its children),
+// recording the dotted path of anything it drops into *pruned. List-typed values are kept whole
+// whenever any part of the list is represented in owned, since matching managedFields ownership
+// against individual associative-list elements is not attempted here.
+func pruneUnowned(v interface{}, owned *fieldpath.Set, path []string, pruned *[]string) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	out := map[string]interface{}{}
+	for k, val := range m {
+		fieldName := k
+		pe := fieldpath.PathElement{FieldName: &fieldName}
+		childPath := append(append([]string{}, path...), k)
+		switch {
+		case owned.Members.Has(pe):
+			out[k] = val
+		case owned.Children.Has(pe):
+			child, _ := owned.Children.Get(pe)
+			out[k] = pruneUnowned(val, child, childPath, pruned)
+		default:
+			*pruned = append(*pruned, strings.Join(childPath, "."))
+		}
+	}
+	return out
 }
 
 // parseImportID processes the resource ID string passed by the user to the "terraform import" command
 // and extracts the values for GVK, name and (optionally) namespace of the target resource as required
 // during the import process.
 //
-// The expected format for the import resource ID is:
-//
-// "<apiGroup/><apiVersion>#<Kind>#<namespace>#<name>"
+// Several formats are accepted, so that users can import using whatever identifier is already familiar
+// to them. The format is inferred from the ID's separators and the shape of its first path segment:
 //
-// where 'namespace' is only required for resources that expect a namespace.
+//   - legacy:        "<apiGroup/><apiVersion>#<Kind>#<namespace>#<name>"   (namespace optional, '#' separated)
+//   - explicit GVK:  "<apiGroup>/<apiVersion>/<Kind>/<namespace>/<name>"   (namespace optional, apiGroup empty for core resources)
+//   - kubectl-style: "<Kind>.<apiGroup>.<apiVersion>/<namespace>/<name>"   (namespace optional, apiGroup empty for core resources)
+//   - Kind-only:     "<Kind>/<namespace>/<name>"                          (namespace optional; group/version resolved via the RESTMapper)
 //
-// Note the '#' separator between the elements of the ID string.
+// A '#' anywhere in the ID selects the legacy form. Otherwise, if the path segment before the first '/'
+// contains a '.' and the portion before that '.' is itself a plausible Kind (UpperCamelCase), the
+// kubectl-style form is used — checking for a Kind-shaped prefix, rather than just the presence of a
+// '.', is what lets this distinguish "Certificate.cert-manager.io.v1/..." (kubectl-style) from
+// "cert-manager.io/v1/Certificate/..." (explicit GVK with a dotted apiGroup), since the latter's first
+// segment contains dots too but does not start with an uppercase letter. Otherwise, if that segment
+// starts with an uppercase letter, it is assumed to be a bare Kind and is resolved to a group/version
+// through the RESTMapper, erroring if the Kind is ambiguous across more than one API group. Anything
+// else is parsed as the explicit GVK slash form.
 //
 // Example: "v1#Secret#default#default-token-qgm6s"
-//
-func parseImportID(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+func parseImportID(id string, rm meta.RESTMapper) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	seg := firstPathSegment(id)
+	switch {
+	case strings.Contains(id, "#"):
+		gvk, name, namespace, err = parseImportIDLegacy(id)
+	case isKubectlStyleKindSpec(seg):
+		gvk, name, namespace, err = parseImportIDKubectlStyle(id)
+	case isUpperCamel(seg):
+		gvk, name, namespace, err = parseImportIDKindOnly(id, rm)
+	default:
+		gvk, name, namespace, err = parseImportIDSlashGVK(id)
+	}
+	if err != nil {
+		err = fmt.Errorf("%w\n%s", err, acceptedImportIDForms)
+	}
+	return
+}
+
+// isKubectlStyleKindSpec reports whether seg (the path segment before the ID's first '/') looks like a
+// kubectl-style "<Kind>.<apiGroup>.<apiVersion>" spec: it contains a '.', and the portion before the
+// first '.' is itself UpperCamelCase, as a Kind name would be. This is what disambiguates it from the
+// explicit GVK form's apiGroup segment, which frequently contains dots too (e.g. "cert-manager.io",
+// "monitoring.coreos.com") but conventionally starts with a lowercase letter.
+func isKubectlStyleKindSpec(seg string) bool {
+	dotIdx := strings.Index(seg, ".")
+	if dotIdx <= 0 {
+		return false
+	}
+	return isUpperCamel(seg[:dotIdx])
+}
+
+// acceptedImportIDForms lists every import ID format parseImportID accepts, for inclusion in its
+// parse errors so that a user who got one format wrong can discover the others.
+const acceptedImportIDForms = `accepted import ID formats:
+  - "<apiVersion>#<Kind>#<namespace>#<name>" or "<apiVersion>#<Kind>#<name>" (legacy, '#' separated)
+  - "<apiGroup>/<apiVersion>/<Kind>/<namespace>/<name>" or "<apiGroup>/<apiVersion>/<Kind>/<name>" (explicit GVK)
+  - "<Kind>.<apiGroup>.<apiVersion>/<namespace>/<name>" or "<Kind>.<apiGroup>.<apiVersion>/<name>" (kubectl-style)
+  - "<Kind>/<namespace>/<name>" or "<Kind>/<name>" (Kind-only, group/version resolved via the RESTMapper)`
+
+func parseImportIDLegacy(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
 	parts := strings.Split(id, "#")
 	if len(parts) < 3 || len(parts) > 4 {
-		err = fmt.Errorf("invalid format for import ID [%s]", id)
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<apiVersion>#<Kind>#<namespace>#<name>\" or \"<apiVersion>#<Kind>#<name>\"", id)
 		return
 	}
 	gvk = schema.FromAPIVersionAndKind(parts[0], parts[1])
@@ -183,4 +733,137 @@ func parseImportID(id string) (gvk schema.GroupVersionKind, name string, namespa
 		name = parts[2]
 	}
 	return
-}
\ No newline at end of file
+}
+
+func parseImportIDSlashGVK(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 4 || len(parts) > 5 {
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<apiGroup>/<apiVersion>/<Kind>/<namespace>/<name>\" or \"<apiGroup>/<apiVersion>/<Kind>/<name>\"", id)
+		return
+	}
+	gvk = schema.GroupVersionKind{Group: parts[0], Version: parts[1], Kind: parts[2]}
+	if len(parts) == 5 {
+		namespace = parts[3]
+		name = parts[4]
+	} else {
+		name = parts[3]
+	}
+	return
+}
+
+func parseImportIDKubectlStyle(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	kindSpec := firstPathSegment(id)
+	rest := strings.TrimPrefix(id, kindSpec+"/")
+	if rest == id {
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<Kind>.<apiGroup>.<apiVersion>/<namespace>/<name>\" or \"<Kind>.<apiGroup>.<apiVersion>/<name>\"", id)
+		return
+	}
+	kindParts := strings.Split(kindSpec, ".")
+	if len(kindParts) < 2 {
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<Kind>.<apiGroup>.<apiVersion>/...\"", id)
+		return
+	}
+	restParts := strings.Split(rest, "/")
+	if len(restParts) < 1 || len(restParts) > 2 {
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<Kind>.<apiGroup>.<apiVersion>/<namespace>/<name>\" or \"<Kind>.<apiGroup>.<apiVersion>/<name>\"", id)
+		return
+	}
+	gvk = schema.GroupVersionKind{
+		Kind:    kindParts[0],
+		Group:   strings.Join(kindParts[1:len(kindParts)-1], "."),
+		Version: kindParts[len(kindParts)-1],
+	}
+	if len(restParts) == 2 {
+		namespace = restParts[0]
+		name = restParts[1]
+	} else {
+		name = restParts[0]
+	}
+	return
+}
+
+func parseImportIDKindOnly(id string, rm meta.RESTMapper) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		err = fmt.Errorf("invalid format for import ID [%s]: expected \"<Kind>/<namespace>/<name>\" or \"<Kind>/<name>\"", id)
+		return
+	}
+	kind := parts[0]
+	if len(parts) == 3 {
+		namespace = parts[1]
+		name = parts[2]
+	} else {
+		name = parts[1]
+	}
+	gvk, err = resolveGVKForKind(kind, rm)
+	return
+}
+
+// parseSelectorImportID parses the remainder of a "selector:" bulk import ID:
+// "<Kind>[.<apiGroup>.<apiVersion>]/<namespace>/<labelSelector>".
+func parseSelectorImportID(spec string, rm meta.RESTMapper) (gvk schema.GroupVersionKind, namespace string, selector string, err error) {
+	parts := strings.SplitN(spec, "/", 3)
+	if len(parts) != 3 {
+		err = fmt.Errorf("invalid format for selector import ID [%s]: expected \"selector:<Kind>[.<apiGroup>.<apiVersion>]/<namespace>/<labelSelector>\"", spec)
+		return
+	}
+	kindSpec := parts[0]
+	namespace, selector = parts[1], parts[2]
+	gvk, err = resolveGVKForKindSpec(kindSpec, rm)
+	return
+}
+
+// parseNamespaceImportID parses the remainder of a "namespace:" bulk import ID:
+// "<Kind>[.<apiGroup>.<apiVersion>]/<namespace-or-*>".
+func parseNamespaceImportID(spec string, rm meta.RESTMapper) (gvk schema.GroupVersionKind, namespace string, err error) {
+	idx := strings.LastIndex(spec, "/")
+	if idx < 0 {
+		err = fmt.Errorf("invalid format for namespace import ID [%s]: expected \"namespace:<Kind>[.<apiGroup>.<apiVersion>]/<namespace-or-*>\"", spec)
+		return
+	}
+	kindSpec, namespace := spec[:idx], spec[idx+1:]
+	gvk, err = resolveGVKForKindSpec(kindSpec, rm)
+	return
+}
+
+// resolveGVKForKindSpec resolves either a bare Kind (via the RESTMapper) or a dotted
+// "<Kind>.<apiGroup>.<apiVersion>" spec (explicit, no RESTMapper lookup needed) to a GVK.
+func resolveGVKForKindSpec(kindSpec string, rm meta.RESTMapper) (schema.GroupVersionKind, error) {
+	if !strings.Contains(kindSpec, ".") {
+		return resolveGVKForKind(kindSpec, rm)
+	}
+	kindParts := strings.Split(kindSpec, ".")
+	if len(kindParts) < 2 {
+		return schema.GroupVersionKind{}, fmt.Errorf("invalid Kind spec [%s]: expected \"<Kind>.<apiGroup>.<apiVersion>\"", kindSpec)
+	}
+	return schema.GroupVersionKind{
+		Kind:    kindParts[0],
+		Group:   strings.Join(kindParts[1:len(kindParts)-1], "."),
+		Version: kindParts[len(kindParts)-1],
+	}, nil
+}
+
+// resolveGVKForKind resolves a bare Kind name to a GVK via the RESTMapper, using its preferred
+// version. It errors if the RESTMapper cannot map the Kind, i.e. if the Kind is unknown or ambiguous
+// across more than one API group.
+func resolveGVKForKind(kind string, rm meta.RESTMapper) (schema.GroupVersionKind, error) {
+	mapping, err := rm.RESTMapping(schema.GroupKind{Kind: kind})
+	if err != nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("could not resolve group/version for Kind %q via RESTMapper: %w", kind, err)
+	}
+	return mapping.GroupVersionKind, nil
+}
+
+// firstPathSegment returns the portion of id before its first '/', or id itself if it contains none.
+func firstPathSegment(id string) string {
+	if i := strings.Index(id, "/"); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
+
+// isUpperCamel reports whether s looks like a Kubernetes Kind name (starts with an uppercase letter),
+// as opposed to an apiGroup or apiVersion string, which are conventionally lowercase.
+func isUpperCamel(s string) bool {
+	return s != "" && s[0] >= 'A' && s[0] <= 'Z'
+}
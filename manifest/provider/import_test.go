@@ -0,0 +1,608 @@
+package provider
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestParseManifestFileImportID(t *testing.T) {
+	cases := []struct {
+		name         string
+		id           string
+		wantPath     string
+		wantDocIndex int
+		wantHasIndex bool
+		wantErr      bool
+	}{
+		{
+			name:     "stdin",
+			id:       "-",
+			wantPath: "-",
+		},
+		{
+			name:     "file path without document index",
+			id:       "file:///tmp/manifests.yaml",
+			wantPath: "/tmp/manifests.yaml",
+		},
+		{
+			name:         "file path with document index",
+			id:           "file:///tmp/manifests.yaml#2",
+			wantPath:     "/tmp/manifests.yaml",
+			wantDocIndex: 2,
+			wantHasIndex: true,
+		},
+		{
+			name:    "non-numeric document index errors",
+			id:      "file:///tmp/manifests.yaml#abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, docIndex, hasDocIndex, err := parseManifestFileImportID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if path != tc.wantPath {
+				t.Errorf("path = %q, want %q", path, tc.wantPath)
+			}
+			if docIndex != tc.wantDocIndex {
+				t.Errorf("docIndex = %d, want %d", docIndex, tc.wantDocIndex)
+			}
+			if hasDocIndex != tc.wantHasIndex {
+				t.Errorf("hasDocIndex = %v, want %v", hasDocIndex, tc.wantHasIndex)
+			}
+		})
+	}
+}
+
+func TestDecodeYAMLDocuments(t *testing.T) {
+	t.Run("multiple YAML documents, skipping empty ones", func(t *testing.T) {
+		input := "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: a\n---\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: b\n"
+		docs, err := decodeYAMLDocuments(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(docs) != 2 {
+			t.Fatalf("got %d documents, want 2", len(docs))
+		}
+		if name, _ := docs[0]["metadata"].(map[string]interface{})["name"].(string); name != "a" {
+			t.Errorf("docs[0] name = %q, want %q", name, "a")
+		}
+		if name, _ := docs[1]["metadata"].(map[string]interface{})["name"].(string); name != "b" {
+			t.Errorf("docs[1] name = %q, want %q", name, "b")
+		}
+	})
+
+	t.Run("single JSON document", func(t *testing.T) {
+		input := `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"a"}}`
+		docs, err := decodeYAMLDocuments(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(docs) != 1 {
+			t.Fatalf("got %d documents, want 1", len(docs))
+		}
+	})
+
+	t.Run("empty input yields no documents", func(t *testing.T) {
+		docs, err := decodeYAMLDocuments(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(docs) != 0 {
+			t.Errorf("got %d documents, want 0", len(docs))
+		}
+	})
+}
+
+// TestManifestFileDocIndexOutOfRange exercises parseManifestFileImportID and decodeYAMLDocuments
+// together the way importResourcesFromManifestFile does, to confirm a requested document index past
+// the end of the decoded documents is detectable before any indexing is attempted.
+func TestManifestFileDocIndexOutOfRange(t *testing.T) {
+	input := "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: a\n"
+	docs, err := decodeYAMLDocuments(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, docIndex, hasDocIndex, err := parseManifestFileImportID("file:///tmp/manifests.yaml#5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !hasDocIndex {
+		t.Fatal("expected hasDocIndex to be true")
+	}
+	if docIndex < 0 || docIndex >= len(docs) {
+		return
+	}
+	t.Fatalf("document index %d is within range of %d decoded documents, expected out of range", docIndex, len(docs))
+}
+
+// errNotImplemented is returned by the fakeRESTMapper methods that these tests never exercise.
+var errNotImplemented = errors.New("not implemented")
+
+// fakeRESTMapper is a minimal meta.RESTMapper for exercising the Kind-resolution paths in this file.
+// Only RESTMapping and RESTMappings are backed by mappings; the rest are stubs (errNotImplemented, or
+// the identity for ResourceSingularizer) that these tests do not exercise.
+type fakeRESTMapper struct {
+	mappings map[string][]*meta.RESTMapping
+}
+
+func (f *fakeRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	switch matches := f.mappings[gk.Kind]; len(matches) {
+	case 0:
+		return nil, &meta.NoKindMatchError{GroupKind: gk}
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, &meta.AmbiguousKindError{PartialKind: gk}
+	}
+}
+
+func (f *fakeRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	return f.mappings[gk.Kind], nil
+}
+
+func (f *fakeRESTMapper) KindFor(schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	return schema.GroupVersionKind{}, errNotImplemented
+}
+
+func (f *fakeRESTMapper) KindsFor(schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	return nil, errNotImplemented
+}
+
+func (f *fakeRESTMapper) ResourceFor(schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return schema.GroupVersionResource{}, errNotImplemented
+}
+
+func (f *fakeRESTMapper) ResourcesFor(schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	return nil, errNotImplemented
+}
+
+func (f *fakeRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	return resource, nil
+}
+
+func TestResolveGVKForKind(t *testing.T) {
+	podMapping := &meta.RESTMapping{GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}}
+	rm := &fakeRESTMapper{mappings: map[string][]*meta.RESTMapping{
+		"Pod": {podMapping},
+		"Ambiguous": {
+			{GroupVersionKind: schema.GroupVersionKind{Group: "a", Version: "v1", Kind: "Ambiguous"}},
+			{GroupVersionKind: schema.GroupVersionKind{Group: "b", Version: "v1", Kind: "Ambiguous"}},
+		},
+	}}
+
+	t.Run("known kind resolves", func(t *testing.T) {
+		gvk, err := resolveGVKForKind("Pod", rm)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gvk != podMapping.GroupVersionKind {
+			t.Errorf("gvk = %v, want %v", gvk, podMapping.GroupVersionKind)
+		}
+	})
+
+	t.Run("ambiguous kind errors", func(t *testing.T) {
+		if _, err := resolveGVKForKind("Ambiguous", rm); err == nil {
+			t.Fatal("expected an error for an ambiguous Kind")
+		}
+	})
+
+	t.Run("unknown kind errors", func(t *testing.T) {
+		if _, err := resolveGVKForKind("Bogus", rm); err == nil {
+			t.Fatal("expected an error for an unknown Kind")
+		}
+	})
+}
+
+func TestParseImportID(t *testing.T) {
+	rm := &fakeRESTMapper{mappings: map[string][]*meta.RESTMapping{
+		"Pod": {{GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}}},
+	}}
+
+	cases := []struct {
+		name          string
+		id            string
+		wantGVK       schema.GroupVersionKind
+		wantName      string
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name:          "legacy with namespace",
+			id:            "v1#Secret#default#default-token-qgm6s",
+			wantGVK:       schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			wantNamespace: "default",
+			wantName:      "default-token-qgm6s",
+		},
+		{
+			name:     "legacy without namespace",
+			id:       "v1#Namespace#my-ns",
+			wantGVK:  schema.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+			wantName: "my-ns",
+		},
+		{
+			name:          "explicit GVK with namespace",
+			id:            "apps/v1/Deployment/default/nginx",
+			wantGVK:       schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			wantNamespace: "default",
+			wantName:      "nginx",
+		},
+		{
+			name:          "explicit GVK with a dotted apiGroup",
+			id:            "cert-manager.io/v1/Certificate/default/my-cert",
+			wantGVK:       schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+			wantNamespace: "default",
+			wantName:      "my-cert",
+		},
+		{
+			name:     "explicit GVK, core group, no namespace",
+			id:       "/v1/Namespace/my-ns",
+			wantGVK:  schema.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+			wantName: "my-ns",
+		},
+		{
+			name:          "kubectl-style with namespace",
+			id:            "Deployment.apps.v1/default/nginx",
+			wantGVK:       schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			wantNamespace: "default",
+			wantName:      "nginx",
+		},
+		{
+			name:     "kubectl-style, core group, no namespace",
+			id:       "Namespace.v1/my-ns",
+			wantGVK:  schema.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+			wantName: "my-ns",
+		},
+		{
+			name:          "Kind-only resolved via RESTMapper, with namespace",
+			id:            "Pod/default/nginx",
+			wantGVK:       schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			wantNamespace: "default",
+			wantName:      "nginx",
+		},
+		{
+			name:    "invalid legacy format",
+			id:      "v1#Secret",
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind via RESTMapper",
+			id:      "Bogus/default/name",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gvk, name, namespace, err := parseImportID(tc.id, rm)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				if !strings.Contains(err.Error(), "accepted import ID formats") {
+					t.Errorf("expected error to list all accepted formats, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gvk != tc.wantGVK {
+				t.Errorf("gvk = %v, want %v", gvk, tc.wantGVK)
+			}
+			if name != tc.wantName {
+				t.Errorf("name = %q, want %q", name, tc.wantName)
+			}
+			if namespace != tc.wantNamespace {
+				t.Errorf("namespace = %q, want %q", namespace, tc.wantNamespace)
+			}
+		})
+	}
+}
+
+func TestParseSelectorImportID(t *testing.T) {
+	rm := &fakeRESTMapper{mappings: map[string][]*meta.RESTMapping{
+		"Pod": {{GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}}},
+	}}
+
+	cases := []struct {
+		name         string
+		spec         string
+		wantGVK      schema.GroupVersionKind
+		wantNS       string
+		wantSelector string
+		wantErr      bool
+	}{
+		{
+			name:         "bare kind, simple selector",
+			spec:         "Pod/kube-system/app=etcd",
+			wantGVK:      schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			wantNS:       "kube-system",
+			wantSelector: "app=etcd",
+		},
+		{
+			name:         "bare kind, selector containing a slash",
+			spec:         "Pod/kube-system/app.kubernetes.io/name=etcd",
+			wantGVK:      schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			wantNS:       "kube-system",
+			wantSelector: "app.kubernetes.io/name=etcd",
+		},
+		{
+			name:         "dotted Kind.group.version spec",
+			spec:         "Deployment.apps.v1/default/app=foo",
+			wantGVK:      schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			wantNS:       "default",
+			wantSelector: "app=foo",
+		},
+		{
+			name:    "missing selector errors",
+			spec:    "Pod/kube-system",
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind errors",
+			spec:    "Bogus/default/app=foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gvk, ns, selector, err := parseSelectorImportID(tc.spec, rm)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gvk != tc.wantGVK {
+				t.Errorf("gvk = %v, want %v", gvk, tc.wantGVK)
+			}
+			if ns != tc.wantNS {
+				t.Errorf("namespace = %q, want %q", ns, tc.wantNS)
+			}
+			if selector != tc.wantSelector {
+				t.Errorf("selector = %q, want %q", selector, tc.wantSelector)
+			}
+		})
+	}
+}
+
+func TestParseNamespaceImportID(t *testing.T) {
+	rm := &fakeRESTMapper{mappings: map[string][]*meta.RESTMapping{
+		"Pod": {{GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}}},
+	}}
+
+	cases := []struct {
+		name    string
+		spec    string
+		wantGVK schema.GroupVersionKind
+		wantNS  string
+		wantErr bool
+	}{
+		{
+			name:    "bare kind, explicit namespace",
+			spec:    "Pod/kube-system",
+			wantGVK: schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			wantNS:  "kube-system",
+		},
+		{
+			name:    "bare kind, all namespaces",
+			spec:    "Pod/*",
+			wantGVK: schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			wantNS:  "*",
+		},
+		{
+			name:    "dotted Kind.group.version spec",
+			spec:    "Deployment.apps.v1/default",
+			wantGVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			wantNS:  "default",
+		},
+		{
+			name:    "missing namespace errors",
+			spec:    "Pod",
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind errors",
+			spec:    "Bogus/default",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gvk, ns, err := parseNamespaceImportID(tc.spec, rm)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gvk != tc.wantGVK {
+				t.Errorf("gvk = %v, want %v", gvk, tc.wantGVK)
+			}
+			if ns != tc.wantNS {
+				t.Errorf("namespace = %q, want %q", ns, tc.wantNS)
+			}
+		})
+	}
+}
+
+func newTestObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              "web",
+			"namespace":         "default",
+			"resourceVersion":   "12345",
+			"uid":               "a1b2c3d4",
+			"creationTimestamp": "2024-01-01T00:00:00Z",
+			"generation":        int64(2),
+			"labels": map[string]interface{}{
+				"app":        "web",
+				"managed-by": "argo",
+			},
+			"annotations": map[string]interface{}{
+				"argo.example.com/last-sync": "abc123",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+		},
+	}}
+}
+
+func withManagedFields(ro *unstructured.Unstructured, entries ...metav1.ManagedFieldsEntry) *unstructured.Unstructured {
+	ro.SetManagedFields(entries)
+	return ro
+}
+
+func managedFieldsEntry(manager string, fieldsJSON string) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{
+		Manager:  manager,
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(fieldsJSON)},
+	}
+}
+
+func sortedCopy(s []string) []string {
+	out := append([]string(nil), s...)
+	sort.Strings(out)
+	return out
+}
+
+func TestPruneToFieldManager(t *testing.T) {
+	terraformFields := `{"f:spec":{"f:replicas":{}},"f:metadata":{"f:labels":{"f:app":{}}}}`
+	argoFields := `{"f:metadata":{"f:labels":{"f:managed-by":{}},"f:annotations":{"f:argo.example.com/last-sync":{}}},"f:status":{"f:phase":{}}}`
+
+	t.Run("importFieldManagerAll returns content unmodified", func(t *testing.T) {
+		ro := withManagedFields(newTestObject(), managedFieldsEntry("terraform", terraformFields))
+		kept, pruned, err := pruneToFieldManager(ro, importFieldManagerAll)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pruned != nil {
+			t.Errorf("pruned = %v, want nil", pruned)
+		}
+		status, ok := kept["status"].(map[string]interface{})
+		if !ok || status["phase"] != "Running" {
+			t.Errorf("expected status.phase to be preserved, got kept[status] = %v", kept["status"])
+		}
+	})
+
+	t.Run("prunes fields not owned by the configured manager", func(t *testing.T) {
+		ro := withManagedFields(newTestObject(),
+			managedFieldsEntry("terraform", terraformFields),
+			managedFieldsEntry("argo", argoFields),
+		)
+		kept, pruned, err := pruneToFieldManager(ro, "terraform")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if kept["apiVersion"] != "apps/v1" || kept["kind"] != "Deployment" {
+			t.Errorf("expected apiVersion/kind to always be kept, got apiVersion=%v kind=%v", kept["apiVersion"], kept["kind"])
+		}
+
+		md, _ := kept["metadata"].(map[string]interface{})
+		if md == nil {
+			t.Fatal("expected metadata to be kept")
+		}
+		if md["name"] != "web" || md["namespace"] != "default" {
+			t.Errorf("expected metadata.name/namespace to always be kept, got %v", md)
+		}
+		labels, _ := md["labels"].(map[string]interface{})
+		if labels["app"] != "web" {
+			t.Errorf("expected metadata.labels.app (owned by terraform) to be kept, got %v", labels)
+		}
+		if _, ok := labels["managed-by"]; ok {
+			t.Errorf("expected metadata.labels.managed-by (owned by argo) to be pruned, got %v", labels)
+		}
+		if _, ok := md["annotations"]; ok {
+			t.Errorf("expected metadata.annotations (owned entirely by argo) to be pruned, got %v", md["annotations"])
+		}
+		for _, k := range serverSideMetadataFields {
+			if _, ok := md[k]; ok {
+				t.Errorf("expected metadata.%s (system bookkeeping, owned by no manager) to be pruned, got %v", k, md[k])
+			}
+		}
+
+		spec, _ := kept["spec"].(map[string]interface{})
+		if spec["replicas"] != int64(3) {
+			t.Errorf("expected spec.replicas (owned by terraform) to be kept, got %v", spec)
+		}
+		if _, ok := kept["status"]; ok {
+			t.Errorf("expected status (owned entirely by argo) to be pruned, got %v", kept["status"])
+		}
+
+		wantPruned := sortedCopy([]string{"metadata.labels.managed-by", "metadata.annotations", "status"})
+		gotPruned := sortedCopy(pruned)
+		if strings.Join(gotPruned, ",") != strings.Join(wantPruned, ",") {
+			t.Errorf("pruned = %v, want %v", gotPruned, wantPruned)
+		}
+
+		neverReported := append([]string{"apiVersion", "kind", "metadata", "metadata.name", "metadata.namespace"}, serverSideMetadataPaths()...)
+		for _, identifying := range neverReported {
+			for _, p := range pruned {
+				if p == identifying {
+					t.Errorf("field %q must never be reported as owned by another field manager, got pruned = %v", identifying, pruned)
+				}
+			}
+		}
+	})
+
+	t.Run("manager owning nothing still keeps identifying fields only", func(t *testing.T) {
+		ro := withManagedFields(newTestObject(), managedFieldsEntry("argo", argoFields))
+		kept, pruned, err := pruneToFieldManager(ro, "terraform")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		md, _ := kept["metadata"].(map[string]interface{})
+		if md["name"] != "web" || md["namespace"] != "default" {
+			t.Errorf("expected metadata.name/namespace to be kept even with no ownership, got %v", md)
+		}
+		if _, ok := md["labels"]; ok {
+			t.Errorf("expected metadata.labels to be pruned entirely, got %v", md["labels"])
+		}
+		if _, ok := kept["spec"]; ok {
+			t.Errorf("expected spec to be pruned entirely, got %v", kept["spec"])
+		}
+
+		neverReported := append([]string{"apiVersion", "kind", "metadata", "metadata.name", "metadata.namespace"}, serverSideMetadataPaths()...)
+		for _, identifying := range neverReported {
+			for _, p := range pruned {
+				if p == identifying {
+					t.Errorf("field %q must never be reported as owned by another field manager, got pruned = %v", identifying, pruned)
+				}
+			}
+		}
+	})
+}